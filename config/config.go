@@ -0,0 +1,199 @@
+// Package config loads gruyere's user settings from
+// $XDG_CONFIG_HOME/gruyere/config.toml (falling back to ~/.config), merging
+// whatever the file sets over the built-in defaults.
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/charmbracelet/bubbles/key"
+
+	"gruyere/process"
+)
+
+// Config holds every user-tunable setting.
+type Config struct {
+	RefreshInterval  time.Duration
+	DefaultSignal    process.Signal
+	CommandDenylist  []string
+	CommandAllowlist []string
+	RemoteHosts      []string
+	Keys             KeyMap
+	Colors           Colors
+}
+
+// KeyMap is the set of rebindable actions.
+type KeyMap struct {
+	Enter      key.Binding
+	Left       key.Binding
+	Right      key.Binding
+	Quit       key.Binding
+	SwitchHost key.Binding
+}
+
+// Colors overrides the lipgloss hex colors gruyere renders with.
+type Colors struct {
+	Accent string
+	Subtle string
+}
+
+// Default returns gruyere's built-in settings, used whenever the config
+// file is absent or a field is left unset.
+func Default() Config {
+	return Config{
+		RefreshInterval: time.Second,
+		DefaultSignal:   process.SIGKILL,
+		// sshd and systemd keep the box reachable; never offer to kill them.
+		CommandDenylist: []string{"sshd", "systemd"},
+		Keys: KeyMap{
+			Enter:      key.NewBinding(key.WithKeys("enter")),
+			Left:       key.NewBinding(key.WithKeys("left")),
+			Right:      key.NewBinding(key.WithKeys("right")),
+			Quit:       key.NewBinding(key.WithKeys("ctrl+c")),
+			SwitchHost: key.NewBinding(key.WithKeys("ctrl+r")),
+		},
+		Colors: Colors{
+			Accent: "#874BFD",
+			Subtle: "#383838",
+		},
+	}
+}
+
+// Killable reports whether info may be offered for killing at all, given
+// the configured command allowlist/denylist.
+func (c Config) Killable(info process.Info) bool {
+	for _, name := range c.CommandDenylist {
+		if info.Command == name {
+			return false
+		}
+	}
+
+	if len(c.CommandAllowlist) == 0 {
+		return true
+	}
+	for _, name := range c.CommandAllowlist {
+		if info.Command == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Path returns the config file gruyere reads, honoring XDG_CONFIG_HOME.
+func Path() (string, error) {
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		dir = filepath.Join(home, ".config")
+	}
+
+	return filepath.Join(dir, "gruyere", "config.toml"), nil
+}
+
+// fileConfig mirrors the TOML schema; every field is optional so a user
+// can set as little or as much as they like.
+type fileConfig struct {
+	RefreshInterval  string   `toml:"refresh_interval"`
+	DefaultSignal    string   `toml:"default_signal"`
+	CommandDenylist  []string `toml:"command_denylist"`
+	CommandAllowlist []string `toml:"command_allowlist"`
+	RemoteHosts      []string `toml:"remote_hosts"`
+	Keys             struct {
+		Enter      []string `toml:"enter"`
+		Left       []string `toml:"left"`
+		Right      []string `toml:"right"`
+		Quit       []string `toml:"quit"`
+		SwitchHost []string `toml:"switch_host"`
+	} `toml:"keys"`
+	Colors struct {
+		Accent string `toml:"accent"`
+		Subtle string `toml:"subtle"`
+	} `toml:"colors"`
+}
+
+// Load reads the config file at Path, merging it over Default. A missing
+// file is not an error -- it just means the defaults apply untouched.
+func Load() (Config, error) {
+	cfg := Default()
+
+	path, err := Path()
+	if err != nil {
+		return cfg, err
+	}
+
+	var raw fileConfig
+	if _, err := toml.DecodeFile(path, &raw); err != nil {
+		if os.IsNotExist(err) {
+			return cfg, nil
+		}
+		return cfg, fmt.Errorf("config: %w", err)
+	}
+
+	merge(&cfg, raw)
+	return cfg, nil
+}
+
+func merge(cfg *Config, raw fileConfig) {
+	if raw.RefreshInterval != "" {
+		if d, err := time.ParseDuration(raw.RefreshInterval); err == nil {
+			cfg.RefreshInterval = d
+		}
+	}
+	if sig, ok := parseSignal(raw.DefaultSignal); ok {
+		cfg.DefaultSignal = sig
+	}
+	if len(raw.CommandDenylist) > 0 {
+		cfg.CommandDenylist = raw.CommandDenylist
+	}
+	if len(raw.CommandAllowlist) > 0 {
+		cfg.CommandAllowlist = raw.CommandAllowlist
+	}
+	if len(raw.RemoteHosts) > 0 {
+		cfg.RemoteHosts = raw.RemoteHosts
+	}
+
+	if len(raw.Keys.Enter) > 0 {
+		cfg.Keys.Enter = key.NewBinding(key.WithKeys(raw.Keys.Enter...))
+	}
+	if len(raw.Keys.Left) > 0 {
+		cfg.Keys.Left = key.NewBinding(key.WithKeys(raw.Keys.Left...))
+	}
+	if len(raw.Keys.Right) > 0 {
+		cfg.Keys.Right = key.NewBinding(key.WithKeys(raw.Keys.Right...))
+	}
+	if len(raw.Keys.Quit) > 0 {
+		cfg.Keys.Quit = key.NewBinding(key.WithKeys(raw.Keys.Quit...))
+	}
+	if len(raw.Keys.SwitchHost) > 0 {
+		cfg.Keys.SwitchHost = key.NewBinding(key.WithKeys(raw.Keys.SwitchHost...))
+	}
+
+	if raw.Colors.Accent != "" {
+		cfg.Colors.Accent = raw.Colors.Accent
+	}
+	if raw.Colors.Subtle != "" {
+		cfg.Colors.Subtle = raw.Colors.Subtle
+	}
+}
+
+func parseSignal(name string) (process.Signal, bool) {
+	switch name {
+	case "SIGTERM":
+		return process.SIGTERM, true
+	case "SIGHUP":
+		return process.SIGHUP, true
+	case "SIGINT":
+		return process.SIGINT, true
+	case "SIGKILL":
+		return process.SIGKILL, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,108 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"gruyere/process"
+)
+
+func TestMergeAppliesFileValuesOverDefaults(t *testing.T) {
+	cfg := Default()
+
+	var raw fileConfig
+	raw.RefreshInterval = "2s"
+	raw.DefaultSignal = "SIGTERM"
+	raw.CommandDenylist = []string{"init"}
+	raw.CommandAllowlist = []string{"node"}
+	raw.RemoteHosts = []string{"alice@example.com"}
+	raw.Keys.Enter = []string{"e"}
+	raw.Colors.Accent = "#000000"
+
+	merge(&cfg, raw)
+
+	if cfg.RefreshInterval != 2*time.Second {
+		t.Errorf("RefreshInterval = %v, want 2s", cfg.RefreshInterval)
+	}
+	if cfg.DefaultSignal != process.SIGTERM {
+		t.Errorf("DefaultSignal = %v, want SIGTERM", cfg.DefaultSignal)
+	}
+	if !reflect.DeepEqual(cfg.CommandDenylist, []string{"init"}) {
+		t.Errorf("CommandDenylist = %v, want [init]", cfg.CommandDenylist)
+	}
+	if !reflect.DeepEqual(cfg.CommandAllowlist, []string{"node"}) {
+		t.Errorf("CommandAllowlist = %v, want [node]", cfg.CommandAllowlist)
+	}
+	if !reflect.DeepEqual(cfg.RemoteHosts, []string{"alice@example.com"}) {
+		t.Errorf("RemoteHosts = %v, want [alice@example.com]", cfg.RemoteHosts)
+	}
+	if !reflect.DeepEqual(cfg.Keys.Enter.Keys(), []string{"e"}) {
+		t.Errorf("Keys.Enter = %v, want [e]", cfg.Keys.Enter.Keys())
+	}
+	if cfg.Colors.Accent != "#000000" {
+		t.Errorf("Colors.Accent = %q, want #000000", cfg.Colors.Accent)
+	}
+}
+
+func TestMergeLeavesDefaultsWhenFileOmitsFields(t *testing.T) {
+	cfg := Default()
+	want := Default()
+
+	merge(&cfg, fileConfig{})
+
+	if cfg.RefreshInterval != want.RefreshInterval {
+		t.Errorf("RefreshInterval = %v, want %v", cfg.RefreshInterval, want.RefreshInterval)
+	}
+	if cfg.DefaultSignal != want.DefaultSignal {
+		t.Errorf("DefaultSignal = %v, want %v", cfg.DefaultSignal, want.DefaultSignal)
+	}
+	if !reflect.DeepEqual(cfg.CommandDenylist, want.CommandDenylist) {
+		t.Errorf("CommandDenylist = %v, want %v", cfg.CommandDenylist, want.CommandDenylist)
+	}
+	if !reflect.DeepEqual(cfg.Keys.Enter.Keys(), want.Keys.Enter.Keys()) {
+		t.Errorf("Keys.Enter = %v, want %v", cfg.Keys.Enter.Keys(), want.Keys.Enter.Keys())
+	}
+	if cfg.Colors.Accent != want.Colors.Accent {
+		t.Errorf("Colors.Accent = %q, want %q", cfg.Colors.Accent, want.Colors.Accent)
+	}
+}
+
+func TestMergeIgnoresUnparseableOverrides(t *testing.T) {
+	cfg := Default()
+	want := Default()
+
+	merge(&cfg, fileConfig{
+		RefreshInterval: "not-a-duration",
+		DefaultSignal:   "SIGBOGUS",
+	})
+
+	if cfg.RefreshInterval != want.RefreshInterval {
+		t.Errorf("RefreshInterval = %v, want unchanged default %v", cfg.RefreshInterval, want.RefreshInterval)
+	}
+	if cfg.DefaultSignal != want.DefaultSignal {
+		t.Errorf("DefaultSignal = %v, want unchanged default %v", cfg.DefaultSignal, want.DefaultSignal)
+	}
+}
+
+func TestKillable(t *testing.T) {
+	cfg := Config{
+		CommandDenylist:  []string{"sshd"},
+		CommandAllowlist: nil,
+	}
+
+	if cfg.Killable(process.Info{Command: "sshd"}) {
+		t.Error("Killable(sshd) = true, want false (denylisted)")
+	}
+	if !cfg.Killable(process.Info{Command: "nginx"}) {
+		t.Error("Killable(nginx) = false, want true (no allowlist configured)")
+	}
+
+	cfg.CommandAllowlist = []string{"nginx"}
+	if !cfg.Killable(process.Info{Command: "nginx"}) {
+		t.Error("Killable(nginx) = false, want true (allowlisted)")
+	}
+	if cfg.Killable(process.Info{Command: "redis"}) {
+		t.Error("Killable(redis) = true, want false (not in allowlist)")
+	}
+}
@@ -0,0 +1,142 @@
+// Package filter parses the gruyere search bar's query syntax and matches
+// it against processes. A query is whitespace-separated terms: user:,
+// cmd:, port:, and pid: narrow on a specific field, while anything else is
+// treated as free text and fuzzy-matched against the process as a whole.
+package filter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/sahilm/fuzzy"
+
+	"gruyere/process"
+)
+
+// predicate reports whether a process satisfies one parsed query term.
+type predicate func(process.Info) bool
+
+// Query is a parsed search bar query. A process matches if it satisfies
+// every field predicate and fuzzy-matches every free-text term.
+type Query struct {
+	predicates []predicate
+	fuzzyTerms []string
+}
+
+// Parse builds a Query out of raw, the current value of the search bar.
+// Recognized fields are user:, cmd:, port: (a single port or a lo-hi
+// range), and pid: (optionally prefixed with > or < for a comparison).
+// Anything else is kept as a free-text term.
+func Parse(raw string) Query {
+	var q Query
+	for _, term := range strings.Fields(raw) {
+		if p, ok := parseField(term); ok {
+			q.predicates = append(q.predicates, p)
+			continue
+		}
+		q.fuzzyTerms = append(q.fuzzyTerms, term)
+	}
+	return q
+}
+
+// Empty reports whether q has no terms at all, i.e. every process matches.
+func (q Query) Empty() bool {
+	return len(q.predicates) == 0 && len(q.fuzzyTerms) == 0
+}
+
+// Match reports whether info satisfies every predicate and fuzzy term in q.
+func (q Query) Match(info process.Info) bool {
+	for _, p := range q.predicates {
+		if !p(info) {
+			return false
+		}
+	}
+
+	if len(q.fuzzyTerms) == 0 {
+		return true
+	}
+
+	haystack := composite(info)
+	for _, term := range q.fuzzyTerms {
+		if len(fuzzy.Find(term, []string{haystack})) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// composite is the string free-text terms are fuzzy-matched against.
+func composite(info process.Info) string {
+	return fmt.Sprintf("%s %s %s %d", info.User, info.Command, info.Port, info.PID)
+}
+
+// parseField recognizes a "key:value" term and returns the predicate it
+// stands for. ok is false for anything without a known key, which the
+// caller then treats as a free-text term instead.
+func parseField(term string) (predicate, bool) {
+	key, value, found := strings.Cut(term, ":")
+	if !found || value == "" {
+		return nil, false
+	}
+
+	switch key {
+	case "user":
+		return func(info process.Info) bool { return info.User == value }, true
+	case "cmd":
+		return func(info process.Info) bool { return strings.Contains(info.Command, value) }, true
+	case "port":
+		return portPredicate(value)
+	case "pid":
+		return pidPredicate(value)
+	default:
+		return nil, false
+	}
+}
+
+// portPredicate parses value as either an exact port ("3000") or an
+// inclusive range ("3000-4000").
+func portPredicate(value string) (predicate, bool) {
+	lo, hi, isRange := strings.Cut(value, "-")
+	if !isRange {
+		return func(info process.Info) bool { return info.Port == value }, true
+	}
+
+	loN, err := strconv.Atoi(lo)
+	if err != nil {
+		return nil, false
+	}
+	hiN, err := strconv.Atoi(hi)
+	if err != nil {
+		return nil, false
+	}
+
+	return func(info process.Info) bool {
+		port, err := strconv.Atoi(info.Port)
+		return err == nil && port >= loN && port <= hiN
+	}, true
+}
+
+// pidPredicate parses value as an exact PID ("1000") or a comparison
+// against one (">1000", "<1000").
+func pidPredicate(value string) (predicate, bool) {
+	cmp := byte(0)
+	if len(value) > 0 && (value[0] == '>' || value[0] == '<') {
+		cmp = value[0]
+		value = value[1:]
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return nil, false
+	}
+
+	switch cmp {
+	case '>':
+		return func(info process.Info) bool { return info.PID > n }, true
+	case '<':
+		return func(info process.Info) bool { return info.PID < n }, true
+	default:
+		return func(info process.Info) bool { return info.PID == n }, true
+	}
+}
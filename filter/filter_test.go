@@ -0,0 +1,59 @@
+package filter
+
+import (
+	"testing"
+
+	"gruyere/process"
+)
+
+func TestParseAndMatch(t *testing.T) {
+	info := process.Info{PID: 4200, User: "alice", Command: "nginx", Port: "8080"}
+
+	tests := []struct {
+		name  string
+		query string
+		want  bool
+	}{
+		{"empty query matches everything", "", true},
+		{"free text hits the composite string", "nginx", true},
+		{"free text misses", "postgres", false},
+		{"user field exact match", "user:alice", true},
+		{"user field mismatch", "user:bob", false},
+		{"cmd field substring match", "cmd:ngin", true},
+		{"cmd field mismatch", "cmd:redis", false},
+		{"port field exact match", "port:8080", true},
+		{"port field mismatch", "port:3000", false},
+		{"port field in range", "port:8000-9000", true},
+		{"port field out of range", "port:1-100", false},
+		{"pid field exact match", "pid:4200", true},
+		{"pid field mismatch", "pid:1", false},
+		{"pid field greater-than", "pid:>1000", true},
+		{"pid field greater-than false", "pid:>5000", false},
+		{"pid field less-than", "pid:<5000", true},
+		{"pid field less-than false", "pid:<1000", false},
+		{"multiple terms all match", "user:alice cmd:nginx", true},
+		{"multiple terms one fails", "user:alice cmd:redis", false},
+		{"unknown field falls back to free text", "foo:bar", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := Parse(tt.query)
+			if got := q.Match(info); got != tt.want {
+				t.Errorf("Parse(%q).Match(info) = %v, want %v", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQueryEmpty(t *testing.T) {
+	if !Parse("").Empty() {
+		t.Error("Parse(\"\").Empty() = false, want true")
+	}
+	if Parse("nginx").Empty() {
+		t.Error("Parse(\"nginx\").Empty() = true, want false")
+	}
+	if Parse("user:alice").Empty() {
+		t.Error("Parse(\"user:alice\").Empty() = true, want false")
+	}
+}
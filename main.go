@@ -1,20 +1,28 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"os"
 	"os/exec"
-	"regexp"
-	"runtime"
 	"strings"
 	"time"
 
+	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/log"
 	"github.com/lucasb-eyer/go-colorful"
 	"golang.org/x/term"
+
+	"gruyere/config"
+	"gruyere/filter"
+	"gruyere/pane"
+	"gruyere/process"
+	"gruyere/remote"
+	"gruyere/watcher"
 )
 
 var baseStyle = lipgloss.NewStyle()
@@ -60,11 +68,37 @@ var (
 				Underline(true)
 
 	docStyle = lipgloss.NewStyle().Padding(1, 2, 1, 2)
+
+	// Panes.
+	paneStyle = lipgloss.NewStyle().
+			Border(lipgloss.NormalBorder()).
+			BorderForeground(subtle).
+			Padding(0, 1)
+
+	activePaneStyle = paneStyle.Copy().
+			BorderForeground(lipgloss.Color("#874BFD"))
 )
 
+// applyColors overrides the subtle/accent colors above with whatever the
+// user's config sets, leaving everything else (fonts, borders, spacing) as
+// the built-in defaults. Only the dark-background variant of subtle is
+// overridden; light terminals keep #D9DCCF.
+func applyColors(colors config.Colors) {
+	subtle = lipgloss.AdaptiveColor{Light: "#D9DCCF", Dark: colors.Subtle}
+	accent := lipgloss.Color(colors.Accent)
+
+	infoStyle = infoStyle.Copy().BorderForeground(subtle)
+	dialogBoxStyle = dialogBoxStyle.Copy().BorderForeground(accent)
+	activeButtonStyle = activeButtonStyle.Copy().Background(accent)
+	paneStyle = paneStyle.Copy().BorderForeground(subtle)
+	activePaneStyle = activePaneStyle.Copy().BorderForeground(accent)
+}
+
 type item struct {
-	title string
-	desc  string
+	title   string
+	desc    string
+	pid     int
+	command string
 }
 
 func (i item) Title() string       { return i.title }
@@ -74,16 +108,194 @@ func (i item) FilterValue() string { return i.title }
 type model struct {
 	list         list.Model
 	selectedPort string
-	activeButton string
+	dialogChoice int
+	watcher      *watcher.PortWatcher
+	pane         *pane.Layout
+	cfg          config.Config
+	lister       process.Lister
+
+	filterInput  textinput.Model
+	filtering    bool
+	query        filter.Query
+	allProcesses []process.Info
+
+	hosts       []string
+	hostPicker  list.Model
+	pickingHost bool
+}
+
+// hostItem is one entry in the host picker opened with the SwitchHost
+// binding: "local" plus whatever addresses are configured as RemoteHosts.
+type hostItem struct {
+	addr string
+}
+
+func (h hostItem) Title() string {
+	if h.addr == "" {
+		return "local"
+	}
+	return h.addr
+}
+
+func (h hostItem) Description() string {
+	if h.addr == "" {
+		return "processes on this machine"
+	}
+	return "via SSH"
+}
+
+func (h hostItem) FilterValue() string { return h.Title() }
+
+// newHostPicker builds the list shown by the host picker: local, then
+// every configured remote host.
+func newHostPicker(hosts []string) list.Model {
+	items := make([]list.Item, 0, len(hosts)+1)
+	items = append(items, hostItem{})
+	for _, addr := range hosts {
+		items = append(items, hostItem{addr: addr})
+	}
+
+	l := list.New(items, list.NewDefaultDelegate(), 0, 0)
+	l.SetShowTitle(false)
+	l.SetFilteringEnabled(false)
+	return l
+}
+
+// newFilterInput returns the textinput used for the "/" search bar.
+func newFilterInput() textinput.Model {
+	ti := textinput.New()
+	ti.Prompt = "/"
+	ti.Placeholder = "user:root cmd:node port:3000-4000 pid:>1000 ..."
+	return ti
+}
+
+// applyFilter narrows infos down to whatever matches q and converts the
+// survivors into list items.
+func applyFilter(infos []process.Info, q filter.Query) []list.Item {
+	if q.Empty() {
+		return toItems(infos)
+	}
+
+	matched := make([]process.Info, 0, len(infos))
+	for _, info := range infos {
+		if q.Match(info) {
+			matched = append(matched, info)
+		}
+	}
+	return toItems(matched)
 }
 
 var doc = strings.Builder{}
 
-type tickMsg time.Time
+// localLister lists and kills processes on the machine gruyere runs on.
+// Switching to a remote host via the host picker swaps model.lister out
+// for a *remote.Lister instead; switching back reuses this one.
+var localLister = process.New()
+
+// escalateGrace is how long an "Escalate" kill waits after SIGTERM before
+// following up with SIGKILL.
+const escalateGrace = 5 * time.Second
+
+// dialogOption is one button in the kill confirmation dialog.
+type dialogOption struct {
+	label    string
+	signal   process.Signal
+	escalate bool
+	cancel   bool
+}
+
+var dialogOptions = []dialogOption{
+	{label: "SIGTERM", signal: process.SIGTERM},
+	{label: "SIGHUP", signal: process.SIGHUP},
+	{label: "SIGINT", signal: process.SIGINT},
+	{label: "SIGKILL", signal: process.SIGKILL},
+	{label: "Escalate", signal: process.SIGTERM, escalate: true},
+	{label: "Cancel", cancel: true},
+}
+
+// dialogChoiceFor returns the dialogOptions index for sig, so the dialog
+// opens on the user's configured default signal -- hitting enter without
+// moving the cursor sends that signal, like this dialog always did before
+// signal choice existed.
+func dialogChoiceFor(sig process.Signal) int {
+	for i, opt := range dialogOptions {
+		if !opt.cancel && !opt.escalate && opt.signal == sig {
+			return i
+		}
+	}
+	return len(dialogOptions) - 1
+}
+
+// processKilledMsg is emitted once an escalated kill finishes, so the list
+// can refresh immediately instead of waiting for the next watcher poll.
+type processKilledMsg struct {
+	pid int
+	err error
+}
+
+// escalateKill sends SIGTERM, polls for up to grace waiting for the
+// process to exit, and falls back to SIGKILL if it's still alive.
+func escalateKill(lister process.Lister, pid int, grace time.Duration) tea.Cmd {
+	return func() tea.Msg {
+		if err := lister.Kill(pid, process.SIGTERM); err != nil {
+			return processKilledMsg{pid: pid, err: err}
+		}
+
+		deadline := time.Now().Add(grace)
+		for time.Now().Before(deadline) {
+			if !lister.Alive(pid) {
+				return processKilledMsg{pid: pid}
+			}
+			time.Sleep(200 * time.Millisecond)
+		}
+
+		return processKilledMsg{pid: pid, err: lister.Kill(pid, process.SIGKILL)}
+	}
+}
+
+// hostDialedMsg carries the outcome of switching hosts from the host
+// picker. Dialing happens in a tea.Cmd since it can block on the network.
+type hostDialedMsg struct {
+	lister process.Lister
+	addr   string
+	err    error
+}
+
+// processesRefreshedMsg carries the result of re-listing processes.
+// Refreshing happens in a tea.Cmd since lister.List can block on the
+// network when lister is a remote.Lister.
+type processesRefreshedMsg struct {
+	processes []process.Info
+}
+
+// refreshProcesses re-lists processes via lister in a tea.Cmd, the same way
+// dialHost dials in one, so a remote lsof round-trip can't freeze Update.
+func refreshProcesses(lister process.Lister) tea.Cmd {
+	return func() tea.Msg {
+		return processesRefreshedMsg{processes: getProcesses(lister)}
+	}
+}
+
+// dialHost connects to addr ("" selects the local lister) and reports the
+// result back as a hostDialedMsg.
+func dialHost(addr string) tea.Cmd {
+	return func() tea.Msg {
+		if addr == "" {
+			return hostDialedMsg{lister: localLister}
+		}
+
+		l, err := remote.Dial(addr)
+		if err != nil {
+			return hostDialedMsg{addr: addr, err: err}
+		}
+		return hostDialedMsg{lister: l, addr: addr}
+	}
+}
 
 func (m model) Init() tea.Cmd {
 	renderTitle()
-	return tickCmd()
+	m.watcher.Start()
+	return waitForWatcher(m.watcher.Events())
 }
 
 func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
@@ -91,62 +303,174 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
-		if msg.String() == "ctrl+c" {
+		if key.Matches(msg, m.cfg.Keys.Quit) {
 			return m, tea.Quit
 		}
 
+		// Open the search bar
+		if msg.String() == "/" && !m.filtering && !m.pickingHost && m.selectedPort == "" {
+			m.filtering = true
+			m.filterInput.Focus()
+			return m, textinput.Blink
+		}
+
+		// Open the host picker
+		if key.Matches(msg, m.cfg.Keys.SwitchHost) && !m.filtering && m.selectedPort == "" {
+			m.pickingHost = true
+			return m, nil
+		}
+
+		// While the host picker is open, enter dials the highlighted host
+		// and esc cancels; everything else moves the picker's cursor.
+		if m.pickingHost {
+			switch msg.String() {
+			case "enter":
+				host := m.hostPicker.SelectedItem().(hostItem)
+				m.pickingHost = false
+				return m, dialHost(host.addr)
+			case "esc":
+				m.pickingHost = false
+				return m, nil
+			}
+			var cmd tea.Cmd
+			m.hostPicker, cmd = m.hostPicker.Update(msg)
+			return m, cmd
+		}
+
+		// While the search bar is open, every keystroke goes to it instead
+		// of the list: type to narrow the query, enter/esc to stop editing.
+		if m.filtering {
+			switch msg.String() {
+			case "enter":
+				m.filtering = false
+				m.filterInput.Blur()
+			case "esc":
+				m.filtering = false
+				m.filterInput.Blur()
+				m.filterInput.SetValue("")
+				m.query = filter.Query{}
+				m.list.SetItems(applyFilter(m.allProcesses, m.query))
+			default:
+				var cmd tea.Cmd
+				m.filterInput, cmd = m.filterInput.Update(msg)
+				m.query = filter.Parse(m.filterInput.Value())
+				m.list.SetItems(applyFilter(m.allProcesses, m.query))
+				return m, cmd
+			}
+			return m, nil
+		}
+
+		// Switch keyboard focus between the list, detail, and log panes
+		if (msg.String() == "tab" || msg.String() == "ctrl+w") && m.selectedPort == "" {
+			m.pane.Cycle()
+			return m, nil
+		}
+
+		// Resize the list/detail split -- there's no mouse-drag here since
+		// that would need the bubblezone dependency we dropped for
+		// breaking the build, so it's keyboard-only.
+		if m.selectedPort == "" {
+			switch msg.String() {
+			case "<":
+				m.pane.Shrink()
+				m.list.SetSize(m.pane.ListWidth(), m.pane.Height())
+				return m, nil
+			case ">":
+				m.pane.Grow()
+				m.list.SetSize(m.pane.ListWidth(), m.pane.Height())
+				return m, nil
+			}
+		}
+
 		// Select a port
-		if msg.String() == "enter" {
+		if key.Matches(msg, m.cfg.Keys.Enter) && m.list.SelectedItem() != nil {
 			if m.selectedPort == "" {
-				port := m.list.SelectedItem().FilterValue()
-				m.selectedPort = port
+				if m.pane.Focus != pane.List {
+					return m, nil
+				}
+				selected := m.list.SelectedItem().(item)
+				if !m.cfg.Killable(process.Info{Command: selected.command}) {
+					log.Error("Refusing to offer killing a process excluded by the command allowlist/denylist -", selected.command)
+					return m, nil
+				}
+				m.selectedPort = selected.title
+				m.dialogChoice = dialogChoiceFor(m.cfg.DefaultSignal)
 			} else {
-				// If accepted killing the port, grab PID + get an exec.Cmd for killing a port from killPortCmd()
-				if m.activeButton == "yes" {
-					rgx := regexp.MustCompile(`\((.*?)\)`)
-					pid := rgx.FindStringSubmatch(m.list.SelectedItem().FilterValue())[1]
-
-					killCmd, err := killPortCmd(pid)
-					if err != nil {
-						log.Fatal(err)
-					}
+				opt := dialogOptions[m.dialogChoice]
+				selected := m.list.SelectedItem().(item)
+				m.selectedPort = ""
 
-					// Wrap the exec.Cmd in a tea.Cmd and append to cmds []tea.Cmd which will be batched
-					cmds = append(cmds, tea.ExecProcess(killCmd, func(err error) tea.Msg {
-						return err
-					}))
+				if opt.cancel {
+					return m, nil
+				}
 
-					m.list.ResetFilter()
+				if opt.escalate {
+					return m, escalateKill(m.lister, selected.pid, escalateGrace)
+				}
 
-					// Get running processes again when a process is killed
-					m.list.SetItems(getProcesses())
+				if err := m.lister.Kill(selected.pid, opt.signal); err != nil {
+					log.Error("Could not kill process -", err)
 				}
-				// In all cases, reset selected port at the end
-				m.selectedPort = ""
+
+				// Get running processes again when a process is killed
+				return m, refreshProcesses(m.lister)
 			}
 		}
 
-		// If we reach the dialog to confirm killing a port (and therefore have selected a port)
-		if msg.String() == "right" && m.activeButton != "no" {
-			m.activeButton = "no"
+		// Move the highlighted button in the kill confirmation dialog
+		if m.selectedPort != "" {
+			if key.Matches(msg, m.cfg.Keys.Right) && m.dialogChoice < len(dialogOptions)-1 {
+				m.dialogChoice++
+			}
+			if key.Matches(msg, m.cfg.Keys.Left) && m.dialogChoice > 0 {
+				m.dialogChoice--
+			}
 		}
-		if msg.String() == "left" && m.activeButton == "no" {
-			m.activeButton = "yes"
+
+	case processKilledMsg:
+		if msg.err != nil {
+			log.Error("Could not kill process -", msg.err)
 		}
+		return m, refreshProcesses(m.lister)
 
-	case tickMsg:
-		cmd := m.list.SetItems(getProcesses())
-		return m, tea.Batch(tickCmd(), cmd)
+	case processesRefreshedMsg:
+		m.allProcesses = msg.processes
+		cmd := m.list.SetItems(applyFilter(m.allProcesses, m.query))
+		return m, cmd
+
+	case hostDialedMsg:
+		if msg.err != nil {
+			log.Error("Could not switch host -", msg.err)
+			return m, nil
+		}
+
+		if closer, ok := m.lister.(interface{ Close() error }); ok {
+			closer.Close()
+		}
+		m.watcher.Stop()
+
+		m.lister = msg.lister
+		m.watcher = watcher.New(m.lister, m.cfg.RefreshInterval, 5*m.cfg.RefreshInterval)
+		m.watcher.Start()
+		return m, tea.Batch(waitForWatcher(m.watcher.Events()), refreshProcesses(m.lister))
+
+	case watcher.Batch:
+		m.allProcesses = msg.Snapshot
+		cmd := m.list.SetItems(applyFilter(m.allProcesses, m.query))
+		return m, tea.Batch(waitForWatcher(m.watcher.Events()), cmd)
 
 	case tea.WindowSizeMsg:
 		h, v := docStyle.GetFrameSize()
-		m.list.SetSize(msg.Width-h, msg.Height-v)
+		m.pane.SetSize(msg.Width-h, msg.Height-v)
+		m.list.SetSize(m.pane.ListWidth(), m.pane.Height())
 	}
 
-	var cmd tea.Cmd
-	m.list, cmd = m.list.Update(msg)
-
-	cmds = append(cmds, cmd)
+	// Only the focused pane's keystrokes should move the list cursor.
+	if _, isKey := msg.(tea.KeyMsg); !isKey || m.pane.Focus == pane.List {
+		var cmd tea.Cmd
+		m.list, cmd = m.list.Update(msg)
+		cmds = append(cmds, cmd)
+	}
 
 	return m, tea.Batch(cmds...)
 }
@@ -157,25 +481,137 @@ func (m model) View() string {
 		return confirmationView(m)
 	}
 
-	m.list.SetHeight(20)
-	// Otherwise, we just show the list of processes
-	return docStyle.Render(m.list.View())
+	if m.pickingHost {
+		return hostPickerView(m)
+	}
+
+	m.list.SetHeight(m.pane.Height())
+
+	listPane, detailPane, logPane := paneStyle, paneStyle, paneStyle
+	switch m.pane.Focus {
+	case pane.List:
+		listPane = activePaneStyle
+	case pane.Detail:
+		detailPane = activePaneStyle
+	case pane.Log:
+		logPane = activePaneStyle
+	}
+
+	top := lipgloss.JoinHorizontal(lipgloss.Top,
+		listPane.Render(m.list.View()),
+		detailPane.Width(m.pane.DetailWidth()).Render(detailView(m)),
+	)
+	bottom := logPane.Width(m.pane.ListWidth() + m.pane.DetailWidth()).Render(logPaneView(m))
+
+	content := docStyle.Render(lipgloss.JoinVertical(lipgloss.Left, top, bottom))
+
+	if m.filtering || !m.query.Empty() {
+		return lipgloss.JoinVertical(lipgloss.Left, m.filterInput.View(), content)
+	}
+
+	return content
+}
+
+// detailView renders everything lsof knows about the currently highlighted
+// process, truncated to the detail pane's available height.
+func detailView(m model) string {
+	selected, ok := m.list.SelectedItem().(item)
+	if !ok {
+		return "No process selected"
+	}
+
+	return truncateLines(processDetail(selected.pid), m.pane.Height())
+}
+
+// logPaneView renders the tail of the currently highlighted process's
+// stdout, truncated to the log pane's height.
+func logPaneView(m model) string {
+	selected, ok := m.list.SelectedItem().(item)
+	if !ok {
+		return "No process selected"
+	}
+
+	return truncateLines(logTail(selected.pid), m.pane.LogHeight())
+}
+
+// truncateLines keeps only the first n lines of s, so lsof/log output too
+// long for its pane gets cut off instead of overflowing the layout.
+func truncateLines(s string, n int) string {
+	lines := strings.Split(s, "\n")
+	if len(lines) <= n {
+		return s
+	}
+	return strings.Join(lines[:n], "\n")
+}
+
+// logTail best-effort tails the process's stdout via /proc/<pid>/fd/1.
+// Not available for processes we can't read or outside Linux.
+func logTail(pid int) string {
+	out, err := exec.Command("sh", "-c", fmt.Sprintf("timeout 1 tail -c 4096 /proc/%d/fd/1", pid)).CombinedOutput()
+	if err != nil || len(out) == 0 {
+		return "No stdout available for this process"
+	}
+	return string(out)
+}
+
+// processDetail shells out to lsof for the open files and connections held
+// by pid. Best-effort: on platforms without lsof it just says so.
+func processDetail(pid int) string {
+	out, err := exec.Command("lsof", "-p", fmt.Sprintf("%d", pid)).Output()
+	if err != nil {
+		return fmt.Sprintf("Could not read details for PID %d: %v", pid, err)
+	}
+
+	return string(out)
 }
 
 func main() {
+	remoteAddr := flag.String("remote", "", "user@host to list and kill ports on over SSH, instead of locally")
+	flag.Parse()
+
+	cfg, err := config.Load()
+	if err != nil {
+		log.Error("Could not load config, using defaults -", err)
+	}
+	applyColors(cfg.Colors)
+
+	lister := localLister
+	if *remoteAddr != "" {
+		l, err := remote.Dial(*remoteAddr)
+		if err != nil {
+			log.Fatal("Could not connect to remote host -", err)
+		}
+		lister = l
+	}
+
+	hosts := cfg.RemoteHosts
+	if *remoteAddr != "" && !contains(hosts, *remoteAddr) {
+		hosts = append(hosts, *remoteAddr)
+	}
+
 	// Get processes running on listening ports
-	processes := getProcesses()
+	processes := getProcesses(lister)
 
 	//Initialize the model
 	m := model{
-		list:         list.New(processes, list.NewDefaultDelegate(), 0, 0),
+		list:         list.New(applyFilter(processes, filter.Query{}), list.NewDefaultDelegate(), 0, 0),
 		selectedPort: "",
-		activeButton: "yes",
+		dialogChoice: dialogChoiceFor(cfg.DefaultSignal),
+		watcher:      watcher.New(lister, cfg.RefreshInterval, 5*cfg.RefreshInterval),
+		pane:         pane.New(),
+		cfg:          cfg,
+		lister:       lister,
+		allProcesses: processes,
+		filterInput:  newFilterInput(),
+		hosts:        hosts,
+		hostPicker:   newHostPicker(hosts),
 	}
 
 	m.list.SetStatusBarItemName("process", "processes")
 	//Hide default list title + styles
 	m.list.SetShowTitle(false)
+	// The search bar replaces the list's own "/" filter
+	m.list.SetFilteringEnabled(false)
 
 	// Let 'er rip
 	p := tea.NewProgram(m)
@@ -186,67 +622,62 @@ func main() {
 	}
 }
 
-// Used to refresh the running processes on listening ports in the list view
-func tickCmd() tea.Cmd {
-	return tea.Tick(time.Second*1, func(t time.Time) tea.Msg {
-		return tickMsg(t)
-	})
+// waitForWatcher turns the next watcher.Batch into a tea.Msg so the
+// background poller can drive Update without blocking it.
+func waitForWatcher(events <-chan watcher.Batch) tea.Cmd {
+	return func() tea.Msg {
+		return <-events
+	}
 }
 
-func getProcesses() []list.Item {
-	out, _ := exec.Command("lsof", "-i", "-P", "-n", "-sTCP:LISTEN").Output()
-	strStdout := string(out)
-
-	procs := strings.Split(strStdout, "\n")
-	var processes []list.Item
-	for i, proc := range procs {
-		if len(proc) == 0 || i == 0 {
-			continue
-		}
-		pieces := strings.Fields(proc)
-		pid := pieces[1]
-		user := pieces[2]
-		port := strings.Split(pieces[8], ":")[1]
-		command := pieces[0]
+func getProcesses(lister process.Lister) []process.Info {
+	infos, err := lister.List()
+	if err != nil {
+		log.Error("Could not list processes -", err)
+		return nil
+	}
 
-		titleStr := fmt.Sprintf("Port :%s (%s)", port, pid)
-		descStr := fmt.Sprintf("User: %s, Command: %s", user, command)
+	return infos
+}
 
-		processes = append(processes, item{title: titleStr, desc: descStr})
+// contains reports whether addr is already in hosts, so --remote doesn't
+// add a duplicate entry to the host picker.
+func contains(hosts []string, addr string) bool {
+	for _, h := range hosts {
+		if h == addr {
+			return true
+		}
 	}
-
-	return processes
+	return false
 }
 
-// returns a kill exec.Cmd for supported operating systems, otherwise an error.
-func killPortCmd(pid string) (*exec.Cmd, error) {
-	switch runtime.GOOS {
-	case "darwin":
-		return exec.Command("kill", pid), nil
-	case "linux":
-		return exec.Command("kill", pid), nil
+func toItems(infos []process.Info) []list.Item {
+	processes := make([]list.Item, 0, len(infos))
+	for _, info := range infos {
+		titleStr := fmt.Sprintf("Port :%s (%d)", info.Port, info.PID)
+		descStr := fmt.Sprintf("User: %s, Command: %s", info.User, info.Command)
+
+		processes = append(processes, item{title: titleStr, desc: descStr, pid: info.PID, command: info.Command})
 	}
 
-	return nil, fmt.Errorf("operating system not supported: %s", runtime.GOOS)
+	return processes
 }
 
 func confirmationView(m model) string {
 	width, _, _ := term.GetSize(0)
-	var okButton, cancelButton string
 
-	if m.activeButton == "yes" {
-		okButton = activeButtonStyle.Render("Yes")
-		cancelButton = buttonStyle.
-			Render("No, take me back")
-	} else {
-		okButton = buttonStyle.Render("Yes")
-		cancelButton = activeButtonStyle.
-			Render("No, take me back")
+	rendered := make([]string, len(dialogOptions))
+	for i, opt := range dialogOptions {
+		if i == m.dialogChoice {
+			rendered[i] = activeButtonStyle.Render(opt.label)
+		} else {
+			rendered[i] = buttonStyle.Render(opt.label)
+		}
 	}
 
-	qStr := fmt.Sprintf("Are you sure you want to kill port %s?", m.selectedPort)
+	qStr := fmt.Sprintf("How do you want to kill port %s?", m.selectedPort)
 	question := lipgloss.NewStyle().Width(50).Align(lipgloss.Center).Render(qStr)
-	buttons := lipgloss.JoinHorizontal(lipgloss.Top, okButton, cancelButton)
+	buttons := lipgloss.JoinHorizontal(lipgloss.Top, rendered...)
 	ui := lipgloss.JoinVertical(lipgloss.Center, question, buttons)
 
 	dialog := lipgloss.Place(width, 9,
@@ -259,6 +690,22 @@ func confirmationView(m model) string {
 	return baseStyle.Render(dialog + "\n\n")
 }
 
+// hostPickerView renders the list of hosts to switch between, reusing the
+// same dialog chrome as the kill confirmation.
+func hostPickerView(m model) string {
+	width, _, _ := term.GetSize(0)
+	m.hostPicker.SetSize(40, len(m.hostPicker.Items())*3+2)
+
+	dialog := lipgloss.Place(width, 9,
+		lipgloss.Left, lipgloss.Center,
+		dialogBoxStyle.Render(m.hostPicker.View()),
+		lipgloss.WithWhitespaceChars(" "),
+		lipgloss.WithWhitespaceForeground(subtle),
+	)
+
+	return baseStyle.Render(dialog + "\n\n")
+}
+
 func renderTitle() {
 	colors := colorGrid(1, 5)
 	var title strings.Builder
@@ -0,0 +1,113 @@
+// Package pane manages gruyere's three-pane layout (a list on the left, a
+// detail view on the right, and a log tail along the bottom) and tracks
+// which pane currently has keyboard focus.
+package pane
+
+// Focus identifies which pane currently receives keyboard input.
+type Focus int
+
+const (
+	List Focus = iota
+	Detail
+	Log
+)
+
+// Pane-width and log-height tuning.
+const (
+	// defaultListFraction is the share of the total width the list pane
+	// starts with; Grow/Shrink move it within [minListFraction, maxListFraction].
+	defaultListFraction = 0.4
+	minListFraction     = 0.2
+	maxListFraction     = 0.7
+	fractionStep        = 0.05
+
+	// defaultLogHeight is how many rows the bottom log pane gets when
+	// there's room to spare; LogHeight shrinks it on short terminals.
+	defaultLogHeight = 6
+
+	// minContentHeight is the fewest rows the list/detail panes are ever
+	// squeezed down to before the log pane gives up its own rows instead.
+	minContentHeight = 3
+
+	// paneBorderRows is the vertical space a single bordered pane (list,
+	// detail, or log) costs on top of its content, for NormalBorder's top
+	// and bottom rule.
+	paneBorderRows = 2
+)
+
+// Layout tracks the focused pane and the last known terminal size, and
+// divides that size between the list, detail, and log panes.
+type Layout struct {
+	Focus        Focus
+	width        int
+	height       int
+	listFraction float64
+}
+
+// New returns a Layout with the list pane focused and the default split.
+func New() *Layout {
+	return &Layout{Focus: List, listFraction: defaultListFraction}
+}
+
+// SetSize records the total size available to all three panes.
+func (l *Layout) SetSize(width, height int) {
+	l.width = width
+	l.height = height
+}
+
+// Cycle moves keyboard focus to the next pane: list -> detail -> log -> list.
+func (l *Layout) Cycle() {
+	l.Focus = (l.Focus + 1) % 3
+}
+
+// Grow widens the list pane at the detail pane's expense.
+func (l *Layout) Grow() {
+	l.listFraction += fractionStep
+	if l.listFraction > maxListFraction {
+		l.listFraction = maxListFraction
+	}
+}
+
+// Shrink narrows the list pane in the detail pane's favor.
+func (l *Layout) Shrink() {
+	l.listFraction -= fractionStep
+	if l.listFraction < minListFraction {
+		l.listFraction = minListFraction
+	}
+}
+
+// ListWidth returns the width available to the list pane.
+func (l *Layout) ListWidth() int {
+	return int(float64(l.width) * l.listFraction)
+}
+
+// DetailWidth returns the width available to the detail pane.
+func (l *Layout) DetailWidth() int {
+	return l.width - l.ListWidth()
+}
+
+// Height returns the height available to the list and detail panes'
+// content, i.e. the rows left over once both panes' borders and the log
+// pane (borders included) are accounted for.
+func (l *Layout) Height() int {
+	h := l.height - 2*paneBorderRows - l.LogHeight()
+	if h < 0 {
+		return 0
+	}
+	return h
+}
+
+// LogHeight returns the height available to the log pane's content. It
+// shrinks -- down to zero on a short enough terminal -- so the list/detail
+// panes above it always keep at least minContentHeight rows rather than
+// being squeezed out by a log pane that never gives any back.
+func (l *Layout) LogHeight() int {
+	h := defaultLogHeight
+	if avail := l.height - 2*paneBorderRows - minContentHeight; h > avail {
+		h = avail
+	}
+	if h < 0 {
+		h = 0
+	}
+	return h
+}
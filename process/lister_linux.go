@@ -0,0 +1,178 @@
+//go:build linux
+
+package process
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// linuxLister reads /proc/net/tcp{,6} directly instead of shelling out to
+// lsof for every refresh. It falls back to lsof when /proc can't be read
+// (e.g. a restrictive container) or when no listening sockets are found,
+// since hidepid=2 mounts make socket-to-PID resolution impossible.
+type linuxLister struct{}
+
+func newLister() Lister {
+	return linuxLister{}
+}
+
+const tcpListen = "0A" // TCP_LISTEN, see include/net/tcp_states.h
+
+func (linuxLister) List() ([]Info, error) {
+	sockets, err := listeningSockets("/proc/net/tcp")
+	if err != nil {
+		return lsofList()
+	}
+	if sockets6, err := listeningSockets("/proc/net/tcp6"); err == nil {
+		for inode, port := range sockets6 {
+			sockets[inode] = port
+		}
+	}
+	if len(sockets) == 0 {
+		return lsofList()
+	}
+
+	byInode, err := inodeOwners()
+	if err != nil {
+		return lsofList()
+	}
+
+	var infos []Info
+	for inode, port := range sockets {
+		owner, ok := byInode[inode]
+		if !ok {
+			continue
+		}
+		infos = append(infos, Info{
+			PID:     owner.pid,
+			User:    owner.user,
+			Command: owner.command,
+			Port:    port,
+		})
+	}
+
+	return infos, nil
+}
+
+// listeningSockets parses a /proc/net/tcp{,6}-formatted file into a map of
+// socket inode -> local port, keeping only LISTEN-state entries. Keyed by
+// inode rather than port: an IPv4 socket and an unrelated IPv6 socket can
+// listen on the same port number, and merging tcp/tcp6 by port would
+// collapse them into a single entry instead of the two lsof would show.
+func listeningSockets(path string) (map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	sockets := make(map[string]string)
+	scanner := bufio.NewScanner(f)
+	scanner.Scan() // header line
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 10 || fields[3] != tcpListen {
+			continue
+		}
+		localAddr := strings.Split(fields[1], ":")
+		if len(localAddr) != 2 {
+			continue
+		}
+		portNum, err := strconv.ParseUint(localAddr[1], 16, 16)
+		if err != nil {
+			continue
+		}
+		sockets[fields[9]] = fmt.Sprintf("%d", portNum)
+	}
+
+	return sockets, scanner.Err()
+}
+
+type owner struct {
+	pid     int
+	user    string
+	command string
+}
+
+// inodeOwners walks /proc/*/fd to map socket inodes to the PID, owning
+// user, and command name of the process holding them open.
+func inodeOwners() (map[string]owner, error) {
+	entries, err := os.ReadDir("/proc")
+	if err != nil {
+		return nil, err
+	}
+
+	owners := make(map[string]owner)
+	for _, entry := range entries {
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+
+		fdDir := filepath.Join("/proc", entry.Name(), "fd")
+		fds, err := os.ReadDir(fdDir)
+		if err != nil {
+			continue // likely another user's process; not readable
+		}
+
+		var inodes []string
+		for _, fd := range fds {
+			link, err := os.Readlink(filepath.Join(fdDir, fd.Name()))
+			if err != nil {
+				continue
+			}
+			if inode, ok := strings.CutPrefix(link, "socket:["); ok {
+				inodes = append(inodes, strings.TrimSuffix(inode, "]"))
+			}
+		}
+		if len(inodes) == 0 {
+			continue
+		}
+
+		o := owner{pid: pid, user: processUser(pid), command: processCommand(pid)}
+		for _, inode := range inodes {
+			owners[inode] = o
+		}
+	}
+
+	return owners, nil
+}
+
+func processCommand(pid int) string {
+	data, err := os.ReadFile(fmt.Sprintf("/proc/%d/comm", pid))
+	if err != nil {
+		return "?"
+	}
+	return strings.TrimSpace(string(data))
+}
+
+func processUser(pid int) string {
+	info, err := os.Stat(fmt.Sprintf("/proc/%d", pid))
+	if err != nil {
+		return "?"
+	}
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return "?"
+	}
+	u, err := user.LookupId(strconv.FormatUint(uint64(stat.Uid), 10))
+	if err != nil {
+		return strconv.FormatUint(uint64(stat.Uid), 10)
+	}
+	return u.Username
+}
+
+func (linuxLister) Kill(pid int, sig Signal) error {
+	return syscall.Kill(pid, unixSignal(sig))
+}
+
+func (linuxLister) Alive(pid int) bool {
+	return syscall.Kill(pid, 0) == nil
+}
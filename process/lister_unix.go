@@ -0,0 +1,25 @@
+//go:build darwin || freebsd || netbsd || openbsd
+
+package process
+
+import "syscall"
+
+// unixLister is used on darwin and the BSDs, where there's no portable
+// procfs to read socket state from, so lsof remains the source of truth.
+type unixLister struct{}
+
+func newLister() Lister {
+	return unixLister{}
+}
+
+func (unixLister) List() ([]Info, error) {
+	return lsofList()
+}
+
+func (unixLister) Kill(pid int, sig Signal) error {
+	return syscall.Kill(pid, unixSignal(sig))
+}
+
+func (unixLister) Alive(pid int) bool {
+	return syscall.Kill(pid, 0) == nil
+}
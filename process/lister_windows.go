@@ -0,0 +1,104 @@
+//go:build windows
+
+package process
+
+import (
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"golang.org/x/sys/windows"
+)
+
+// windowsLister shells out to netstat and tasklist, since there's no
+// portable equivalent of /proc or lsof on Windows.
+type windowsLister struct{}
+
+func newLister() Lister {
+	return windowsLister{}
+}
+
+func (windowsLister) List() ([]Info, error) {
+	out, err := exec.Command("netstat", "-ano", "-p", "TCP").Output()
+	if err != nil {
+		return nil, fmt.Errorf("netstat: %w", err)
+	}
+
+	names, err := tasklistNames()
+	if err != nil {
+		names = map[int]string{}
+	}
+
+	var infos []Info
+	for _, line := range strings.Split(string(out), "\n") {
+		fields := strings.Fields(line)
+		// Proto  Local Address  Foreign Address  State  PID
+		if len(fields) != 5 || fields[0] != "TCP" || fields[3] != "LISTENING" {
+			continue
+		}
+
+		pid, err := strconv.Atoi(fields[4])
+		if err != nil {
+			continue
+		}
+		localAddr := strings.Split(fields[1], ":")
+		port := localAddr[len(localAddr)-1]
+
+		infos = append(infos, Info{
+			PID:     pid,
+			User:    "?",
+			Command: names[pid],
+			Port:    port,
+		})
+	}
+
+	return infos, nil
+}
+
+// tasklistNames resolves PIDs to image names in one shot, rather than
+// spawning tasklist once per PID.
+func tasklistNames() (map[int]string, error) {
+	out, err := exec.Command("tasklist", "/fo", "csv", "/nh").Output()
+	if err != nil {
+		return nil, fmt.Errorf("tasklist: %w", err)
+	}
+
+	names := make(map[int]string)
+	for _, line := range strings.Split(string(out), "\n") {
+		fields := strings.Split(strings.Trim(line, "\r\n"), "\",\"")
+		if len(fields) < 2 {
+			continue
+		}
+		name := strings.Trim(fields[0], "\"")
+		pid, err := strconv.Atoi(strings.Trim(fields[1], "\""))
+		if err != nil {
+			continue
+		}
+		names[pid] = name
+	}
+
+	return names, nil
+}
+
+// Kill always terminates the process outright: Windows has no equivalent
+// of POSIX signals, so every Signal maps to the same TerminateProcess call.
+func (windowsLister) Kill(pid int, sig Signal) error {
+	handle, err := windows.OpenProcess(windows.PROCESS_TERMINATE, false, uint32(pid))
+	if err != nil {
+		return fmt.Errorf("open process %d: %w", pid, err)
+	}
+	defer windows.CloseHandle(handle)
+
+	return windows.TerminateProcess(handle, 1)
+}
+
+func (windowsLister) Alive(pid int) bool {
+	handle, err := windows.OpenProcess(windows.PROCESS_QUERY_LIMITED_INFORMATION, false, uint32(pid))
+	if err != nil {
+		return false
+	}
+	defer windows.CloseHandle(handle)
+
+	return true
+}
@@ -0,0 +1,53 @@
+package process
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// LsofCommand is the command run to enumerate listening TCP ports via
+// lsof, exported so the remote package can run it over SSH and feed the
+// output back through ParseLsof.
+var LsofCommand = []string{"lsof", "-i", "-P", "-n", "-sTCP:LISTEN"}
+
+// lsofList shells out to lsof to enumerate listening TCP ports. It's used
+// directly on darwin/BSD, and as a fallback on Linux when /proc isn't
+// readable.
+func lsofList() ([]Info, error) {
+	out, err := exec.Command(LsofCommand[0], LsofCommand[1:]...).Output()
+	if err != nil {
+		return nil, fmt.Errorf("lsof: %w", err)
+	}
+
+	return ParseLsof(out), nil
+}
+
+// ParseLsof parses the output of `lsof -i -P -n -sTCP:LISTEN`, whether it
+// came from exec.Command locally or was streamed back over an SSH session.
+func ParseLsof(out []byte) []Info {
+	lines := strings.Split(string(out), "\n")
+	var infos []Info
+	for i, line := range lines {
+		if len(line) == 0 || i == 0 {
+			continue
+		}
+		pieces := strings.Fields(line)
+		if len(pieces) < 9 {
+			continue
+		}
+
+		var pid int
+		fmt.Sscanf(pieces[1], "%d", &pid)
+		port := strings.Split(pieces[8], ":")[1]
+
+		infos = append(infos, Info{
+			PID:     pid,
+			User:    pieces[2],
+			Command: pieces[0],
+			Port:    port,
+		})
+	}
+
+	return infos
+}
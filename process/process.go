@@ -0,0 +1,56 @@
+// Package process enumerates and terminates processes bound to listening
+// TCP ports, with an implementation chosen per operating system.
+package process
+
+// Info describes a single process with a listening TCP port.
+type Info struct {
+	PID     int
+	User    string
+	Command string
+	Port    string
+}
+
+// Signal identifies which termination signal to send a process. It's our
+// own enum, rather than syscall.Signal, so the Lister interface has the
+// same shape on every OS (Windows has no POSIX signals to speak of).
+type Signal int
+
+const (
+	SIGTERM Signal = iota
+	SIGHUP
+	SIGINT
+	SIGKILL
+)
+
+// String returns the conventional signal name, as used in dialogs and logs.
+func (s Signal) String() string {
+	switch s {
+	case SIGTERM:
+		return "SIGTERM"
+	case SIGHUP:
+		return "SIGHUP"
+	case SIGINT:
+		return "SIGINT"
+	case SIGKILL:
+		return "SIGKILL"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// Lister discovers processes bound to listening ports and terminates them
+// by PID. Each operating system gets its own implementation; New returns
+// whichever one matches the platform the binary was built for.
+type Lister interface {
+	// List returns the processes currently listening on a TCP port.
+	List() ([]Info, error)
+	// Kill sends sig to the process with the given PID.
+	Kill(pid int, sig Signal) error
+	// Alive reports whether a process with the given PID still exists.
+	Alive(pid int) bool
+}
+
+// New returns the Lister implementation for the current GOOS.
+func New() Lister {
+	return newLister()
+}
@@ -0,0 +1,19 @@
+//go:build unix
+
+package process
+
+import "syscall"
+
+// unixSignal maps our portable Signal enum to the concrete syscall value.
+func unixSignal(sig Signal) syscall.Signal {
+	switch sig {
+	case SIGTERM:
+		return syscall.SIGTERM
+	case SIGHUP:
+		return syscall.SIGHUP
+	case SIGINT:
+		return syscall.SIGINT
+	default:
+		return syscall.SIGKILL
+	}
+}
@@ -0,0 +1,154 @@
+// Package remote implements process.Lister over SSH, so the same TUI that
+// lists and kills local ports can do the same on a configured remote host.
+package remote
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+	"golang.org/x/crypto/ssh/knownhosts"
+
+	"gruyere/process"
+)
+
+// Lister runs process enumeration and kill commands over a single SSH
+// connection instead of exec.Command, so local and remote hosts share the
+// same process.Lister interface and the same lsof parsing.
+type Lister struct {
+	host   string
+	client *ssh.Client
+}
+
+// Dial opens an SSH connection to addr, which is a "user@host" or
+// "user@host:port" address (port defaults to 22). Authentication is done
+// via whatever keys ssh-agent offers, same as a plain `ssh` invocation.
+func Dial(addr string) (*Lister, error) {
+	user, host, err := splitAddr(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	signers, err := agentSigners()
+	if err != nil {
+		return nil, fmt.Errorf("remote: %w", err)
+	}
+
+	hostKeyCallback, err := knownHostsCallback()
+	if err != nil {
+		return nil, fmt.Errorf("remote: %w", err)
+	}
+
+	config := &ssh.ClientConfig{
+		User:            user,
+		Auth:            []ssh.AuthMethod{ssh.PublicKeys(signers...)},
+		HostKeyCallback: hostKeyCallback,
+	}
+
+	client, err := ssh.Dial("tcp", host, config)
+	if err != nil {
+		return nil, fmt.Errorf("remote: dial %s: %w", addr, err)
+	}
+
+	return &Lister{host: addr, client: client}, nil
+}
+
+// Close shuts down the underlying SSH connection.
+func (l *Lister) Close() error {
+	return l.client.Close()
+}
+
+// String returns the address Lister was dialed with.
+func (l *Lister) String() string {
+	return l.host
+}
+
+// List runs lsof on the remote host and parses its output the same way a
+// local lsof fallback would.
+func (l *Lister) List() ([]process.Info, error) {
+	out, err := l.run(strings.Join(process.LsofCommand, " "))
+	if err != nil {
+		return nil, fmt.Errorf("remote: %s: %w", l.host, err)
+	}
+
+	return process.ParseLsof(out), nil
+}
+
+// Kill sends sig to pid on the remote host via the `kill` command.
+func (l *Lister) Kill(pid int, sig process.Signal) error {
+	cmd := fmt.Sprintf("kill -s %s %d", sig, pid)
+	if _, err := l.run(cmd); err != nil {
+		return fmt.Errorf("remote: %s: %w", l.host, err)
+	}
+	return nil
+}
+
+// Alive reports whether pid still exists on the remote host, via `kill -0`.
+func (l *Lister) Alive(pid int) bool {
+	_, err := l.run(fmt.Sprintf("kill -0 %d", pid))
+	return err == nil
+}
+
+// run executes cmd in a fresh SSH session and returns its combined output.
+func (l *Lister) run(cmd string) ([]byte, error) {
+	session, err := l.client.NewSession()
+	if err != nil {
+		return nil, err
+	}
+	defer session.Close()
+
+	return session.CombinedOutput(cmd)
+}
+
+// splitAddr parses "user@host[:port]" into an SSH user and a host:port
+// pair, defaulting the port to 22.
+func splitAddr(addr string) (user, host string, err error) {
+	user, hostPort, found := strings.Cut(addr, "@")
+	if !found || user == "" || hostPort == "" {
+		return "", "", fmt.Errorf("remote: %q is not a user@host address", addr)
+	}
+
+	if _, _, err := net.SplitHostPort(hostPort); err != nil {
+		hostPort = net.JoinHostPort(hostPort, strconv.Itoa(22))
+	}
+
+	return user, hostPort, nil
+}
+
+// knownHostsCallback verifies remote host keys against ~/.ssh/known_hosts,
+// the same database a plain `ssh` invocation trusts. There's no prompt to
+// add an unseen host in this TUI, so an unrecorded or mismatched key just
+// fails the connection instead of silently trusting it.
+func knownHostsCallback() (ssh.HostKeyCallback, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil, fmt.Errorf("locate known_hosts: %w", err)
+	}
+
+	callback, err := knownhosts.New(filepath.Join(home, ".ssh", "known_hosts"))
+	if err != nil {
+		return nil, fmt.Errorf("load known_hosts: %w", err)
+	}
+	return callback, nil
+}
+
+// agentSigners collects the keys offered by ssh-agent, the same way the
+// `ssh` command authenticates by default.
+func agentSigners() ([]ssh.Signer, error) {
+	sock := os.Getenv("SSH_AUTH_SOCK")
+	if sock == "" {
+		return nil, fmt.Errorf("SSH_AUTH_SOCK is not set; is ssh-agent running?")
+	}
+
+	conn, err := net.Dial("unix", sock)
+	if err != nil {
+		return nil, fmt.Errorf("connect to ssh-agent: %w", err)
+	}
+
+	return agent.NewClient(conn).Signers()
+}
@@ -0,0 +1,154 @@
+// Package watcher polls a process.Lister in the background and reports only
+// what changed between polls, instead of making callers re-fetch and
+// re-render on every tick.
+//
+// This is adaptive polling only -- there's no NETLINK_INET_DIAG (or any
+// other netlink) subscription here, so updates are at best as fresh as
+// minInterval (and as stale as maxInterval after a quiet period), not truly
+// realtime.
+package watcher
+
+import (
+	"fmt"
+	"time"
+
+	"gruyere/process"
+)
+
+// Kind identifies what happened to a process between two polls.
+type Kind int
+
+const (
+	Added Kind = iota
+	Removed
+	Changed
+)
+
+// Event describes a single process that was added, removed, or changed
+// since the previous poll.
+type Event struct {
+	Kind    Kind
+	Process process.Info
+}
+
+// Batch is everything that changed in one poll, plus the full snapshot it
+// was computed from so a consumer can replace its view outright.
+type Batch struct {
+	Events   []Event
+	Snapshot []process.Info
+}
+
+// PortWatcher polls a process.Lister and publishes a Batch on Events()
+// whenever the set of listening processes changes. It backs off its poll
+// interval during quiet periods and speeds back up the moment something
+// changes, so idle systems aren't polled as aggressively as busy ones.
+type PortWatcher struct {
+	lister      process.Lister
+	minInterval time.Duration
+	maxInterval time.Duration
+
+	events chan Batch
+	stop   chan struct{}
+}
+
+// New returns a PortWatcher that polls lister, backing off between
+// minInterval and maxInterval.
+func New(lister process.Lister, minInterval, maxInterval time.Duration) *PortWatcher {
+	return &PortWatcher{
+		lister:      lister,
+		minInterval: minInterval,
+		maxInterval: maxInterval,
+		events:      make(chan Batch, 1),
+		stop:        make(chan struct{}),
+	}
+}
+
+// Events returns the channel Batches are published on.
+func (w *PortWatcher) Events() <-chan Batch {
+	return w.events
+}
+
+// Start begins polling in the background. It's safe to call once per
+// PortWatcher.
+func (w *PortWatcher) Start() {
+	go w.run()
+}
+
+// Stop halts the polling goroutine.
+func (w *PortWatcher) Stop() {
+	close(w.stop)
+}
+
+func (w *PortWatcher) run() {
+	interval := w.minInterval
+	snapshot := map[string]process.Info{}
+
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-w.stop:
+			return
+		case <-timer.C:
+			current, err := w.lister.List()
+			if err != nil {
+				timer.Reset(interval)
+				continue
+			}
+
+			events, next := diff(snapshot, current)
+			if len(events) > 0 {
+				select {
+				case w.events <- Batch{Events: events, Snapshot: current}:
+					snapshot = next
+					interval = w.minInterval
+				default:
+					// Consumer hasn't drained the last batch yet. Leave
+					// snapshot as-is so these changes are recomputed (and
+					// the send retried) on the next poll instead of being
+					// silently lost.
+				}
+			} else if interval < w.maxInterval {
+				interval += w.minInterval
+				if interval > w.maxInterval {
+					interval = w.maxInterval
+				}
+			}
+
+			timer.Reset(interval)
+		}
+	}
+}
+
+// diff compares the previous snapshot against the current poll, returning
+// the events that changed and the map the next diff should compare against.
+func diff(prev map[string]process.Info, current []process.Info) ([]Event, map[string]process.Info) {
+	next := make(map[string]process.Info, len(current))
+	var events []Event
+
+	for _, info := range current {
+		key := snapshotKey(info)
+		next[key] = info
+
+		old, ok := prev[key]
+		switch {
+		case !ok:
+			events = append(events, Event{Kind: Added, Process: info})
+		case old != info:
+			events = append(events, Event{Kind: Changed, Process: info})
+		}
+	}
+
+	for key, old := range prev {
+		if _, ok := next[key]; !ok {
+			events = append(events, Event{Kind: Removed, Process: old})
+		}
+	}
+
+	return events, next
+}
+
+func snapshotKey(info process.Info) string {
+	return fmt.Sprintf("%d:%s", info.PID, info.Port)
+}
@@ -0,0 +1,101 @@
+package watcher
+
+import (
+	"sort"
+	"testing"
+
+	"gruyere/process"
+)
+
+func TestDiff(t *testing.T) {
+	a := process.Info{PID: 1, Command: "a", Port: "80"}
+	b := process.Info{PID: 2, Command: "b", Port: "81"}
+	bChanged := process.Info{PID: 2, Command: "b-renamed", Port: "81"}
+	c := process.Info{PID: 3, Command: "c", Port: "82"}
+
+	tests := []struct {
+		name    string
+		prev    []process.Info
+		current []process.Info
+		want    []Event
+	}{
+		{
+			name:    "nothing changed",
+			prev:    []process.Info{a, b},
+			current: []process.Info{a, b},
+			want:    nil,
+		},
+		{
+			name:    "process added",
+			prev:    []process.Info{a},
+			current: []process.Info{a, b},
+			want:    []Event{{Kind: Added, Process: b}},
+		},
+		{
+			name:    "process removed",
+			prev:    []process.Info{a, b},
+			current: []process.Info{a},
+			want:    []Event{{Kind: Removed, Process: b}},
+		},
+		{
+			name:    "process changed",
+			prev:    []process.Info{a, b},
+			current: []process.Info{a, bChanged},
+			want:    []Event{{Kind: Changed, Process: bChanged}},
+		},
+		{
+			name:    "added, removed, and changed together",
+			prev:    []process.Info{a, b},
+			current: []process.Info{bChanged, c},
+			want: []Event{
+				{Kind: Changed, Process: bChanged},
+				{Kind: Added, Process: c},
+				{Kind: Removed, Process: a},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prev := map[string]process.Info{}
+			for _, info := range tt.prev {
+				prev[snapshotKey(info)] = info
+			}
+
+			events, next := diff(prev, tt.current)
+
+			sortEvents(events)
+			sortEvents(tt.want)
+			if !eventsEqual(events, tt.want) {
+				t.Errorf("diff() events = %v, want %v", events, tt.want)
+			}
+
+			if len(next) != len(tt.current) {
+				t.Errorf("diff() next snapshot has %d entries, want %d", len(next), len(tt.current))
+			}
+			for _, info := range tt.current {
+				if next[snapshotKey(info)] != info {
+					t.Errorf("diff() next snapshot missing or stale entry for %v", info)
+				}
+			}
+		})
+	}
+}
+
+func sortEvents(events []Event) {
+	sort.Slice(events, func(i, j int) bool {
+		return snapshotKey(events[i].Process) < snapshotKey(events[j].Process)
+	})
+}
+
+func eventsEqual(a, b []Event) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}